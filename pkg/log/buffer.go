@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// bufferedRecord is a snapshot of a single Info/Error call made while a
+// delegatingLogSink's promise was unfulfilled, along with the name and tags
+// of the logger it was made through, so it can be replayed faithfully once
+// the real logSink is known.
+type bufferedRecord struct {
+	name    string
+	tags    []interface{}
+	isError bool
+	err     error
+	level   int
+	msg     string
+	kvs     []interface{}
+}
+
+// preFulfillBuffer is a bounded, threadsafe buffer of bufferedRecords
+// recorded by a delegatingLogSink (and its descendants) before its promise
+// is fulfilled. Records are kept in FIFO order; once full, new Info records
+// are dropped and counted rather than recorded, but a new Error record will
+// evict the oldest buffered Info record to make room, so a burst of
+// low-value logging early in startup cannot crowd out a later Error call.
+// When every buffered record is already an Error, a new Error record is
+// dropped and counted like an Info record.
+type preFulfillBuffer struct {
+	mu      sync.Mutex
+	cap     int
+	records []bufferedRecord
+	dropped int
+}
+
+func newPreFulfillBuffer(n int) *preFulfillBuffer {
+	return &preFulfillBuffer{cap: n}
+}
+
+// record appends rec to the buffer. If the buffer is already full, rec is
+// dropped and counted, unless rec is an Error record, in which case the
+// oldest non-Error record is evicted (and counted as dropped) to make room
+// for it. If there is no non-Error record to evict, rec is dropped and
+// counted.
+func (b *preFulfillBuffer) record(rec bufferedRecord) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.records) < b.cap {
+		b.records = append(b.records, rec)
+		return
+	}
+	if rec.isError {
+		for i := range b.records {
+			if !b.records[i].isError {
+				b.records = append(b.records[:i], b.records[i+1:]...)
+				b.records = append(b.records, rec)
+				b.dropped++
+				return
+			}
+		}
+	}
+	b.dropped++
+}
+
+// replay drains the buffer into actual, reconstructing each record's
+// WithName/WithValues chain on top of it, then logs a single warning for any
+// records that were dropped for exceeding the buffer's capacity.
+func (b *preFulfillBuffer) replay(actual logr.LogSink) {
+	b.mu.Lock()
+	records := b.records
+	dropped := b.dropped
+	b.records = nil
+	b.dropped = 0
+	b.mu.Unlock()
+
+	for _, rec := range records {
+		sink := actual
+		if rec.name != "" {
+			for _, part := range strings.Split(rec.name, ".") {
+				sink = sink.WithName(part)
+			}
+		}
+		if len(rec.tags) > 0 {
+			sink = sink.WithValues(rec.tags...)
+		}
+
+		if rec.isError {
+			sink.Error(rec.err, rec.msg, rec.kvs...)
+		} else {
+			sink.Info(rec.level, rec.msg, rec.kvs...)
+		}
+	}
+
+	if dropped > 0 {
+		actual.Error(nil, "dropped buffered log messages recorded before the logger was set",
+			"droppedMessages", dropped)
+	}
+}
+
+// DelegatingLoggerOption configures a logr.Logger constructed via
+// NewDelegatingLogger.
+type DelegatingLoggerOption func(*delegatingLogSink)
+
+// WithPreFulfillBuffer makes the delegating logger record up to n Info/Error
+// calls made before its promise is fulfilled (i.e. before SetLogger is
+// called), replaying them into the real logSink once Fulfill runs, instead
+// of silently discarding them as the default NullLogSink placeholder does.
+// Once the buffer is full, Info calls beyond n are dropped and counted, but
+// an Error call will evict the oldest buffered Info record to make room for
+// itself rather than being dropped; only when every buffered record is an
+// Error is a new Error call dropped and counted. The drop count is surfaced as a single
+// warning logged against the real sink once it becomes available.
+func WithPreFulfillBuffer(n int) DelegatingLoggerOption {
+	return func(l *delegatingLogSink) {
+		l.buffer = newPreFulfillBuffer(n)
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import "context"
+
+type correlationIDKey struct{}
+type spanIDKey struct{}
+type sampledKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying id as the request-scoped
+// correlation (trace) ID. Any logr.Logger later obtained from this context
+// via FromContext is automatically populated with a "trace_id" key-value
+// pair.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFrom returns the correlation ID previously stored in ctx by
+// WithCorrelationID, if any.
+func CorrelationIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationIDKey{}).(string)
+	return id, ok
+}
+
+// WithSpanID returns a copy of ctx carrying id as the current span ID. Any
+// logr.Logger later obtained from this context via FromContext is
+// automatically populated with a "span_id" key-value pair.
+func WithSpanID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, spanIDKey{}, id)
+}
+
+// SpanIDFrom returns the span ID previously stored in ctx by WithSpanID, if
+// any.
+func SpanIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(spanIDKey{}).(string)
+	return id, ok
+}
+
+// WithSampled returns a copy of ctx carrying sampled as the request-scoped
+// sampling decision. A logr.Logger later obtained from this context via
+// FromContext is suppressed entirely (as though obtained via logr.Discard)
+// when sampled is false, so that call sites don't each need to guard their
+// own logging against the decision.
+func WithSampled(ctx context.Context, sampled bool) context.Context {
+	return context.WithValue(ctx, sampledKey{}, sampled)
+}
+
+// SampledFrom returns the sampling decision previously stored in ctx by
+// WithSampled, if any.
+func SampledFrom(ctx context.Context) (bool, bool) {
+	sampled, ok := ctx.Value(sampledKey{}).(bool)
+	return sampled, ok
+}
+
+// ReconcileLogContext describes the fields a Reconciler can supply so that
+// every log line emitted for a single Reconcile call is pre-populated with
+// the reconcile UID and the target object's GVK and namespace/name.
+type ReconcileLogContext interface {
+	// ReconcileID returns the UID of the current reconcile request.
+	ReconcileID() string
+	// GroupVersionKind returns the group, version, and kind of the object
+	// being reconciled.
+	GroupVersionKind() (group, version, kind string)
+	// NamespacedName returns the namespace and name of the object being
+	// reconciled.
+	NamespacedName() (namespace, name string)
+}
+
+// IntoReconcileContext returns a copy of ctx whose logger (retrievable via
+// FromContext) is pre-populated with the reconcile UID, object GVK, and
+// namespace/name described by rc, so that a Reconcile loop's log lines are
+// consistently attributed without repeating WithValues calls at every call
+// site.
+func IntoReconcileContext(ctx context.Context, rc ReconcileLogContext) context.Context {
+	group, version, kind := rc.GroupVersionKind()
+	namespace, name := rc.NamespacedName()
+
+	logger := FromContext(ctx,
+		"controllerGroup", group,
+		"controllerVersion", version,
+		"controllerKind", kind,
+		"name", name,
+		"namespace", namespace,
+		"reconcileID", rc.ReconcileID(),
+	)
+
+	return IntoContext(ctx, logger)
+}
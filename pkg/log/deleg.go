@@ -92,6 +92,21 @@ type delegatingLogSink struct {
 	logSink logr.LogSink
 	promise *loggerPromise
 	info    logr.RuntimeInfo
+
+	// name is the dotted chain of WithName calls that produced this sink,
+	// consulted against overrides registered via SetLogLevelForName.
+	name string
+	// tags is the cumulative set of key/value pairs accumulated through
+	// WithValues calls made while the promise is unfulfilled (sinks created
+	// after fulfillment leave it empty), snapshotted into bufferedRecords
+	// when buffer is set.
+	tags []interface{}
+
+	// buffer, if non-nil, records Info/Error calls made while promise is
+	// still unfulfilled instead of letting them reach the placeholder
+	// logSink, so they can be replayed once Fulfill runs. Shared with every
+	// descendant produced via WithName/WithValues before fulfillment.
+	buffer *preFulfillBuffer
 }
 
 // Init implements logr.LogSink.
@@ -107,6 +122,14 @@ func (l *delegatingLogSink) Init(info logr.RuntimeInfo) {
 func (l *delegatingLogSink) Enabled(v int) bool {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
+	if maxV, ok := maxVForName(l.name); ok && v > maxV {
+		return false
+	}
+	if l.promise != nil && l.buffer != nil {
+		// Accept every call while buffering so it reaches Info below instead
+		// of being silently dropped by the placeholder logSink's Enabled.
+		return true
+	}
 	return l.logSink.Enabled(v)
 }
 
@@ -119,6 +142,10 @@ func (l *delegatingLogSink) Enabled(v int) bool {
 func (l *delegatingLogSink) Info(level int, msg string, keysAndValues ...interface{}) {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
+	if l.promise != nil && l.buffer != nil {
+		l.buffer.record(bufferedRecord{name: l.name, tags: l.tags, level: level, msg: msg, kvs: keysAndValues})
+		return
+	}
 	l.logSink.Info(level, msg, keysAndValues...)
 }
 
@@ -133,6 +160,10 @@ func (l *delegatingLogSink) Info(level int, msg string, keysAndValues ...interfa
 func (l *delegatingLogSink) Error(err error, msg string, keysAndValues ...interface{}) {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
+	if l.promise != nil && l.buffer != nil {
+		l.buffer.record(bufferedRecord{name: l.name, tags: l.tags, isError: true, err: err, msg: msg, kvs: keysAndValues})
+		return
+	}
 	l.logSink.Error(err, msg, keysAndValues...)
 }
 
@@ -141,11 +172,16 @@ func (l *delegatingLogSink) WithName(name string) logr.LogSink {
 	l.lock.RLock()
 	defer l.lock.RUnlock()
 
+	fullName := name
+	if l.name != "" {
+		fullName = l.name + "." + name
+	}
+
 	if l.promise == nil {
-		return l.logSink.WithName(name)
+		return &delegatingLogSink{logSink: l.logSink.WithName(name), name: fullName}
 	}
 
-	res := &delegatingLogSink{logSink: l.logSink}
+	res := &delegatingLogSink{logSink: l.logSink, name: fullName, tags: l.tags, buffer: l.buffer}
 	promise := l.promise.WithName(res, name)
 	res.promise = promise
 
@@ -158,10 +194,11 @@ func (l *delegatingLogSink) WithValues(tags ...interface{}) logr.LogSink {
 	defer l.lock.RUnlock()
 
 	if l.promise == nil {
-		return l.logSink.WithValues(tags...)
+		return &delegatingLogSink{logSink: l.logSink.WithValues(tags...), name: l.name}
 	}
 
-	res := &delegatingLogSink{logSink: l.logSink}
+	cumulativeTags := append(append([]interface{}(nil), l.tags...), tags...)
+	res := &delegatingLogSink{logSink: l.logSink, name: l.name, tags: cumulativeTags, buffer: l.buffer}
 	promise := l.promise.WithValues(res, tags...)
 	res.promise = promise
 
@@ -175,15 +212,21 @@ func (l *delegatingLogSink) Fulfill(actual logr.LogSink) {
 	if l.promise != nil {
 		l.promise.Fulfill(actual)
 	}
+	if l.buffer != nil {
+		l.buffer.replay(actual)
+	}
 }
 
 // NewDelegatingLogger constructs a new delegatingLogSink which uses
 // the given logSink before it's promise is fulfilled.
-func NewDelegatingLogger(logSink logr.LogSink) logr.Logger {
+func NewDelegatingLogger(logSink logr.LogSink, opts ...DelegatingLoggerOption) logr.Logger {
 	l := &delegatingLogSink{
 		logSink: logSink,
 		promise: &loggerPromise{promisesLock: sync.Mutex{}},
 	}
+	for _, opt := range opts {
+		opt(l)
+	}
 	l.promise.logger = l
 	return logr.New(l)
 }
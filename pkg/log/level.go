@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"strings"
+	"sync"
+)
+
+// levelOverride caps the V-level that loggers whose dotted name equals prefix,
+// or begins with prefix followed by a ".", are allowed to emit, regardless of
+// what the underlying logSink was configured with.
+type levelOverride struct {
+	prefix string
+	v      int
+}
+
+var (
+	levelOverridesMu sync.RWMutex
+	levelOverrides   []levelOverride
+)
+
+// SetLogLevelForName caps the verbosity of any logger whose dotted name
+// (as built up by successive WithName calls) equals prefix, or begins with
+// prefix followed by a ".", to v, throttling noisy controllers without
+// touching their code. A name that merely shares a string prefix (such as
+// "noisycontroller2" for prefix "noisycontroller") is not affected, while
+// an empty prefix applies to every logger. For example,
+// SetLogLevelForName("controller.noisycontroller", 0) silences that
+// controller's V(1) and higher debug logging while leaving Info/Error (V=0)
+// intact. Calling it again for the same prefix replaces the previous value.
+func SetLogLevelForName(prefix string, v int) {
+	levelOverridesMu.Lock()
+	defer levelOverridesMu.Unlock()
+
+	for i := range levelOverrides {
+		if levelOverrides[i].prefix == prefix {
+			levelOverrides[i].v = v
+			return
+		}
+	}
+	levelOverrides = append(levelOverrides, levelOverride{prefix: prefix, v: v})
+}
+
+// maxVForName returns the most specific (longest matching prefix) level
+// override registered for name, if any.
+func maxVForName(name string) (int, bool) {
+	levelOverridesMu.RLock()
+	defer levelOverridesMu.RUnlock()
+
+	best := -1
+	v := 0
+	for _, o := range levelOverrides {
+		if o.prefix != "" && name != o.prefix && !strings.HasPrefix(name, o.prefix+".") {
+			continue
+		}
+		if len(o.prefix) > best {
+			best = len(o.prefix)
+			v = o.v
+		}
+	}
+	return v, best >= 0
+}
@@ -288,6 +288,104 @@ var _ = Describe("logging", func() {
 				logInfo{msg: "msg 2"},
 			))
 		})
+
+		It("should stop V-levels above a SetLogLevelForName override from reaching the logSink", func() {
+			By("registering a logger with a name before fulfill")
+			named := delegLog.WithName("noisycontroller")
+			SetLogLevelForName("noisycontroller", 0)
+
+			By("fulfilling the promise")
+			delegLog.GetSink().(canFulfill).Fulfill(baseLog)
+
+			By("logging at and above the overridden level")
+			named.V(0).Info("info message")
+			named.V(1).Info("debug message")
+
+			By("ensuring only the allowed level was recorded")
+			Expect(root.messages).To(ConsistOf(
+				logInfo{name: []string{"noisycontroller"}, msg: "info message"},
+			))
+		})
+
+		It("should not throttle a logger whose name merely shares a string prefix", func() {
+			By("registering an override for one controller only")
+			named := delegLog.WithName("noisycontroller2")
+			SetLogLevelForName("noisycontroller", 0)
+
+			By("fulfilling the promise")
+			delegLog.GetSink().(canFulfill).Fulfill(baseLog)
+
+			By("logging a debug-level message on the unrelated, similarly-named controller")
+			named.V(1).Info("debug message")
+
+			By("ensuring the unrelated controller was not throttled")
+			Expect(root.messages).To(ConsistOf(
+				logInfo{name: []string{"noisycontroller2"}, msg: "debug message"},
+			))
+		})
+	})
+
+	Describe("buffered pre-fulfillment logging", func() {
+		It("should replay buffered messages once fulfilled instead of dropping them", func() {
+			root := &fakeLoggerRoot{}
+			baseLog := &fakeLogger{root: root}
+			delegLog := NewDelegatingLogger(NullLogSink{}, WithPreFulfillBuffer(10))
+
+			By("logging before the logSink is set")
+			named := delegLog.WithName("runtimeLog").WithValues("tag1", "val1")
+			named.Info("before msg")
+			delegLog.Error(errors.New("boom"), "before error")
+
+			By("ensuring no messages were actually recorded yet")
+			Expect(root.messages).To(BeEmpty())
+
+			By("fulfilling the promise")
+			delegLog.GetSink().(canFulfill).Fulfill(baseLog)
+
+			By("ensuring the buffered messages were replayed")
+			Expect(root.messages).To(ConsistOf(
+				logInfo{name: []string{"runtimeLog"}, tags: []interface{}{"tag1", "val1"}, msg: "before msg"},
+				logInfo{tags: []interface{}{"error", errors.New("boom")}, msg: "before error"},
+			))
+		})
+
+		It("should drop and count messages beyond the buffer's capacity", func() {
+			root := &fakeLoggerRoot{}
+			baseLog := &fakeLogger{root: root}
+			delegLog := NewDelegatingLogger(NullLogSink{}, WithPreFulfillBuffer(1))
+
+			By("logging more messages than the buffer can hold")
+			delegLog.Info("kept")
+			delegLog.Info("dropped")
+
+			By("fulfilling the promise")
+			delegLog.GetSink().(canFulfill).Fulfill(baseLog)
+
+			By("ensuring the kept message and a dropped-count warning were recorded")
+			Expect(root.messages).To(ConsistOf(
+				logInfo{msg: "kept"},
+				logInfo{tags: []interface{}{"error", nil, "droppedMessages", 1}, msg: "dropped buffered log messages recorded before the logger was set"},
+			))
+		})
+
+		It("should evict a buffered Info record to make room for an Error record", func() {
+			root := &fakeLoggerRoot{}
+			baseLog := &fakeLogger{root: root}
+			delegLog := NewDelegatingLogger(NullLogSink{}, WithPreFulfillBuffer(1))
+
+			By("filling the buffer with an Info message, then logging an Error once full")
+			delegLog.Info("info message")
+			delegLog.Error(errors.New("boom"), "error message")
+
+			By("fulfilling the promise")
+			delegLog.GetSink().(canFulfill).Fulfill(baseLog)
+
+			By("ensuring the Error survived and the evicted Info was counted as dropped")
+			Expect(root.messages).To(ConsistOf(
+				logInfo{tags: []interface{}{"error", errors.New("boom")}, msg: "error message"},
+				logInfo{tags: []interface{}{"error", nil, "droppedMessages", 1}, msg: "dropped buffered log messages recorded before the logger was set"},
+			))
+		})
 	})
 
 	Describe("logSink from context", func() {
@@ -327,6 +425,52 @@ var _ = Describe("logging", func() {
 				logInfo{name: []string{"my-logSink"}, tags: []interface{}{"tag1", "value1"}, msg: "test message"},
 			))
 		})
+
+		It("should add a trace_id key-value when the context carries a correlation ID", func() {
+			root := &fakeLoggerRoot{}
+			baseLog := &fakeLogger{root: root}
+
+			wantLog := logr.New(baseLog).WithName("my-logSink")
+			ctx := IntoContext(context.Background(), wantLog)
+			ctx = WithCorrelationID(ctx, "abc-123")
+
+			gotLog := FromContext(ctx)
+			gotLog.Info("test message")
+			Expect(root.messages).To(ConsistOf(
+				logInfo{name: []string{"my-logSink"}, tags: []interface{}{"trace_id", "abc-123"}, msg: "test message"},
+			))
+		})
+
+		It("should discard all logging when the context carries a negative sampling decision", func() {
+			root := &fakeLoggerRoot{}
+			baseLog := &fakeLogger{root: root}
+
+			wantLog := logr.New(baseLog).WithName("my-logSink")
+			ctx := IntoContext(context.Background(), wantLog)
+			ctx = WithSampled(ctx, false)
+
+			gotLog := FromContext(ctx)
+			gotLog.Info("test message")
+			gotLog.Error(errors.New("boom"), "test error")
+
+			Expect(root.messages).To(BeEmpty())
+		})
+
+		It("should log normally when the context carries a positive sampling decision", func() {
+			root := &fakeLoggerRoot{}
+			baseLog := &fakeLogger{root: root}
+
+			wantLog := logr.New(baseLog).WithName("my-logSink")
+			ctx := IntoContext(context.Background(), wantLog)
+			ctx = WithSampled(ctx, true)
+
+			gotLog := FromContext(ctx)
+			gotLog.Info("test message")
+
+			Expect(root.messages).To(ConsistOf(
+				logInfo{name: []string{"my-logSink"}, msg: "test message"},
+			))
+		})
 	})
 
 })
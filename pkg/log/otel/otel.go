@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package otel provides a logr.LogSink that bridges controller-runtime
+// logging into the OpenTelemetry Logs SDK, so that Reconcile logs can be
+// exported through an existing OTel collector pipeline instead of only
+// klog/zap.
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	otellog "go.opentelemetry.io/otel/log"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// logSink is a logr.LogSink that forwards records to an OpenTelemetry
+// log.Logger obtained from a log.LoggerProvider. WithName maps onto the
+// OTel instrumentation scope name, and WithValues onto record attributes.
+type logSink struct {
+	provider otellog.LoggerProvider
+	logger   otellog.Logger
+	name     string
+	values   []interface{}
+}
+
+var _ logr.LogSink = &logSink{}
+
+// NewLogSink returns a logr.LogSink backed by the OpenTelemetry Logs SDK,
+// obtaining its otellog.Logger from provider.
+func NewLogSink(provider otellog.LoggerProvider) logr.LogSink {
+	return &logSink{
+		provider: provider,
+		logger:   provider.Logger(""),
+	}
+}
+
+// SetLoggerWithOTel installs a logr.Logger backed by the OpenTelemetry Logs
+// SDK as controller-runtime's root logger, via the existing
+// log.SetLogger/delegatingLogSink fulfillment machinery.
+func SetLoggerWithOTel(provider otellog.LoggerProvider) {
+	log.SetLogger(logr.New(NewLogSink(provider)))
+}
+
+func (l *logSink) Init(info logr.RuntimeInfo) {}
+
+func (l *logSink) Enabled(level int) bool {
+	return true
+}
+
+func (l *logSink) WithName(name string) logr.LogSink {
+	fullName := name
+	if l.name != "" {
+		fullName = l.name + "." + name
+	}
+	return &logSink{
+		provider: l.provider,
+		logger:   l.provider.Logger(fullName),
+		name:     fullName,
+		values:   l.values,
+	}
+}
+
+func (l *logSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &logSink{
+		provider: l.provider,
+		logger:   l.logger,
+		name:     l.name,
+		values:   append(append([]interface{}(nil), l.values...), keysAndValues...),
+	}
+}
+
+// Info at V=0 is emitted as INFO, V>=1 as DEBUG, matching logr's verbosity
+// convention. Any trace_id/span_id key-values already injected by
+// log.FromContext flow through like any other value in keysAndValues; the
+// sink has no context of its own to consult, since logr.LogSink.Info never
+// carries one.
+func (l *logSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	severity := otellog.SeverityInfo
+	if level >= 1 {
+		severity = otellog.SeverityDebug
+	}
+	l.emit(severity, msg, keysAndValues)
+}
+
+func (l *logSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	if err != nil {
+		keysAndValues = append(keysAndValues, "error", err.Error())
+	}
+	l.emit(otellog.SeverityError, msg, keysAndValues)
+}
+
+func (l *logSink) emit(severity otellog.Severity, msg string, keysAndValues []interface{}) {
+	var record otellog.Record
+	record.SetSeverity(severity)
+	record.SetBody(otellog.StringValue(msg))
+	record.AddAttributes(keyValuesToAttrs(append(append([]interface{}(nil), l.values...), keysAndValues...))...)
+
+	l.logger.Emit(context.Background(), record)
+}
+
+// keyValuesToAttrs converts logr-style alternating key/value pairs into OTel
+// log attributes, stringifying non-string keys and every value with fmt's %v
+// verb.
+func keyValuesToAttrs(keysAndValues []interface{}) []otellog.KeyValue {
+	if len(keysAndValues)%2 != 0 {
+		keysAndValues = append(keysAndValues, "<missing value>")
+	}
+
+	attrs := make([]otellog.KeyValue, 0, len(keysAndValues)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			key = fmt.Sprintf("%v", keysAndValues[i])
+		}
+		attrs = append(attrs, otellog.String(key, fmt.Sprintf("%v", keysAndValues[i+1])))
+	}
+	return attrs
+}
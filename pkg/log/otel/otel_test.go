@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otel
+
+import (
+	"context"
+	"testing"
+
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeProvider is a minimal otellog.LoggerProvider whose fakeLoggers, whatever
+// scope name they were requested under, all append to its emitted slice so
+// emitted records can be inspected in one place.
+type fakeProvider struct {
+	embedded.LoggerProvider
+	emitted []otellog.Record
+}
+
+func (p *fakeProvider) Logger(name string, _ ...otellog.LoggerOption) otellog.Logger {
+	return &fakeLogger{provider: p}
+}
+
+type fakeLogger struct {
+	embedded.Logger
+	provider *fakeProvider
+}
+
+func (l *fakeLogger) Emit(_ context.Context, record otellog.Record) {
+	l.provider.emitted = append(l.provider.emitted, record)
+}
+
+func (l *fakeLogger) Enabled(context.Context, otellog.Record) bool { return true }
+
+func recordAttrs(r otellog.Record) map[string]string {
+	attrs := make(map[string]string, r.AttributesLen())
+	r.WalkAttributes(func(kv otellog.KeyValue) bool {
+		attrs[string(kv.Key)] = kv.Value.AsString()
+		return true
+	})
+	return attrs
+}
+
+func TestSetLoggerWithOTelForwardsContextDerivedFields(t *testing.T) {
+	provider := &fakeProvider{}
+	SetLoggerWithOTel(provider)
+
+	ctx := log.WithCorrelationID(context.Background(), "trace-abc")
+	ctx = log.WithSpanID(ctx, "span-xyz")
+
+	log.FromContext(ctx).WithName("reconciler").Info("reconciled", "key", "value")
+
+	if len(provider.emitted) != 1 {
+		t.Fatalf("got %d emitted records, want 1", len(provider.emitted))
+	}
+
+	got := recordAttrs(provider.emitted[0])
+	want := map[string]string{"trace_id": "trace-abc", "span_id": "span-xyz", "key": "value"}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("attribute %q = %q, want %q (all attrs: %v)", k, got[k], v, got)
+		}
+	}
+}
+
+func TestSetLoggerWithOTelHonorsNegativeSamplingDecision(t *testing.T) {
+	provider := &fakeProvider{}
+	SetLoggerWithOTel(provider)
+
+	ctx := log.WithSampled(context.Background(), false)
+	log.FromContext(ctx).WithName("reconciler").Info("reconciled", "key", "value")
+
+	if len(provider.emitted) != 0 {
+		t.Fatalf("got %d emitted records, want 0 for a context sampled out", len(provider.emitted))
+	}
+}